@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsApiGatewayVpcLink() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsApiGatewayVpcLinkRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAwsApiGatewayVpcLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigateway
+	name := d.Get("name").(string)
+
+	var vpcLink *apigateway.UpdateVpcLinkOutput
+	input := &apigateway.GetVpcLinksInput{}
+
+	for {
+		resp, err := conn.GetVpcLinks(input)
+		if err != nil {
+			return fmt.Errorf("Error describing API Gateway Vpc Links: %s", err)
+		}
+
+		for _, vl := range resp.Items {
+			if aws.StringValue(vl.Name) == name {
+				if vpcLink != nil {
+					return fmt.Errorf("Multiple API Gateway Vpc Links matched; use additional constraints to reduce matches to a single Vpc Link")
+				}
+				vpcLink = vl
+			}
+		}
+
+		if resp.Position == nil {
+			break
+		}
+		input.Position = resp.Position
+	}
+
+	if vpcLink == nil {
+		return fmt.Errorf("No API Gateway Vpc Link matched name %q", name)
+	}
+
+	d.SetId(aws.StringValue(vpcLink.Id))
+	d.Set("description", vpcLink.Description)
+	d.Set("status", vpcLink.Status)
+	d.Set("status_message", vpcLink.StatusMessage)
+	d.Set("target_arns", schema.NewSet(schema.HashString, flattenStringList(vpcLink.TargetArns)))
+	d.Set("tags", tagsToMapGeneric(vpcLink.Tags))
+
+	return nil
+}
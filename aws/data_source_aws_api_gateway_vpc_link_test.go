@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsApiGatewayVpcLink_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-testacc-apigw-vpclink-%s", acctest.RandString(8))
+	dataSourceName := "data.aws_api_gateway_vpc_link.test"
+	resourceName := "aws_api_gateway_vpc_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsApiGatewayVpcLinkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_arns.#", resourceName, "target_arns.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsApiGatewayVpcLinkConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = ["${aws_subnet.test.id}"]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_api_gateway_vpc_link" "test" {
+  name        = %[1]q
+  target_arns = ["${aws_lb.test.arn}"]
+}
+
+data "aws_api_gateway_vpc_link" "test" {
+  name = "${aws_api_gateway_vpc_link.test.name}"
+}
+`, rName)
+}
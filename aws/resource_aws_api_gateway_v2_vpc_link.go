@@ -0,0 +1,236 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsApiGatewayV2VpcLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsApiGatewayV2VpcLinkCreate,
+		Read:   resourceAwsApiGatewayV2VpcLinkRead,
+		Update: resourceAwsApiGatewayV2VpcLinkUpdate,
+		Delete: resourceAwsApiGatewayV2VpcLinkDelete,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsApiGatewayV2VpcLinkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2
+
+	input := &apigatewayv2.CreateVpcLinkInput{
+		Name:             aws.String(d.Get("name").(string)),
+		SubnetIds:        expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		SecurityGroupIds: expandStringSet(d.Get("security_group_ids").(*schema.Set)),
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	resp, err := conn.CreateVpcLink(input)
+	if err != nil {
+		return fmt.Errorf("Error creating API Gateway v2 VPC Link: %s", err)
+	}
+
+	d.SetId(*resp.VpcLinkId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigatewayv2.VpcLinkStatusPending},
+		Target:     []string{apigatewayv2.VpcLinkStatusAvailable},
+		Refresh:    apigatewayv2VpcLinkRefreshStatusFunc(conn, d.Id()),
+		Timeout:    10 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("[WARN] Error waiting for API Gateway v2 VPC Link status to be \"%s\": %s", apigatewayv2.VpcLinkStatusAvailable, err)
+	}
+
+	return resourceAwsApiGatewayV2VpcLinkRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2VpcLinkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2
+
+	resp, err := conn.GetVpcLink(&apigatewayv2.GetVpcLinkInput{
+		VpcLinkId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if ecrerr, ok := err.(awserr.Error); ok {
+			switch ecrerr.Code() {
+			case apigatewayv2.ErrCodeNotFoundException:
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("subnet_ids", schema.NewSet(schema.HashString, flattenStringList(resp.SubnetIds)))
+	d.Set("security_group_ids", schema.NewSet(schema.HashString, flattenStringList(resp.SecurityGroupIds)))
+	d.Set("tags", tagsToMapGeneric(resp.Tags))
+	d.Set("arn", apigatewayV2VpcLinkArn(meta.(*AWSClient), d.Id()))
+
+	return nil
+}
+
+func resourceAwsApiGatewayV2VpcLinkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2
+
+	if d.HasChange("name") {
+		_, err := conn.UpdateVpcLink(&apigatewayv2.UpdateVpcLinkInput{
+			VpcLinkId: aws.String(d.Id()),
+			Name:      aws.String(d.Get("name").(string)),
+		})
+		if err != nil {
+			if ecrerr, ok := err.(awserr.Error); ok {
+				switch ecrerr.Code() {
+				case apigatewayv2.ErrCodeNotFoundException:
+					d.SetId("")
+					return nil
+				}
+			}
+			return fmt.Errorf("Error updating API Gateway v2 VPC Link: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{apigatewayv2.VpcLinkStatusPending},
+			Target:     []string{apigatewayv2.VpcLinkStatusAvailable},
+			Refresh:    apigatewayv2VpcLinkRefreshStatusFunc(conn, d.Id()),
+			Timeout:    10 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("[WARN] Error waiting for API Gateway v2 VPC Link status to be \"%s\": %s", apigatewayv2.VpcLinkStatusAvailable, err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := apigatewayV2VpcLinkUpdateTags(conn, apigatewayV2VpcLinkArn(meta.(*AWSClient), d.Id()), o, n); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsApiGatewayV2VpcLinkRead(d, meta)
+}
+
+func resourceAwsApiGatewayV2VpcLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).apigatewayv2
+
+	_, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
+		VpcLinkId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if ecrerr, ok := err.(awserr.Error); ok {
+			switch ecrerr.Code() {
+			case apigatewayv2.ErrCodeNotFoundException:
+				return nil
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func apigatewayv2VpcLinkRefreshStatusFunc(conn *apigatewayv2.ApiGatewayV2, vl string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.GetVpcLink(&apigatewayv2.GetVpcLinkInput{
+			VpcLinkId: aws.String(vl),
+		})
+		if err != nil {
+			return nil, "failed", err
+		}
+		return resp, *resp.VpcLinkStatus, nil
+	}
+}
+
+func apigatewayV2VpcLinkArn(client *AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.partition,
+		Service:   "apigateway",
+		Region:    client.region,
+		Resource:  fmt.Sprintf("/vpclinks/%s", id),
+	}.String()
+}
+
+// apigatewayV2VpcLinkUpdateTags reconciles tags directly against the
+// apigatewayv2 client's TagResource/UntagResource operations. The
+// setTagsGeneric/diffTagsGeneric helpers used elsewhere in the provider are
+// only wired up for the apigateway (v1) client, so v2 VPC links manage their
+// own tag diff here.
+func apigatewayV2VpcLinkUpdateTags(conn *apigatewayv2.ApiGatewayV2, resourceArn string, oldTagsRaw, newTagsRaw interface{}) error {
+	oldTags := map[string]interface{}{}
+	if oldTagsRaw != nil {
+		oldTags = oldTagsRaw.(map[string]interface{})
+	}
+	newTags := map[string]interface{}{}
+	if newTagsRaw != nil {
+		newTags = newTagsRaw.(map[string]interface{})
+	}
+
+	if len(newTags) > 0 {
+		_, err := conn.TagResource(&apigatewayv2.TagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			Tags:        tagsFromMapGeneric(newTags),
+		})
+		if err != nil {
+			return fmt.Errorf("Error tagging API Gateway v2 VPC Link %s: %s", resourceArn, err)
+		}
+	}
+
+	var removeKeys []*string
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			removeKeys = append(removeKeys, aws.String(k))
+		}
+	}
+	if len(removeKeys) > 0 {
+		_, err := conn.UntagResource(&apigatewayv2.UntagResourceInput{
+			ResourceArn: aws.String(resourceArn),
+			TagKeys:     removeKeys,
+		})
+		if err != nil {
+			return fmt.Errorf("Error untagging API Gateway v2 VPC Link %s: %s", resourceArn, err)
+		}
+	}
+
+	return nil
+}
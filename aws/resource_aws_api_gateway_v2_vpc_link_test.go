@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSApiGatewayV2VpcLink_basic(t *testing.T) {
+	var vpcLink apigatewayv2.GetVpcLinkOutput
+	rName := fmt.Sprintf("tf-testacc-apigwv2-vpclink-%s", acctest.RandString(8))
+	resourceName := "aws_api_gateway_v2_vpc_link.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSApiGatewayV2VpcLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSApiGatewayV2VpcLinkConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSApiGatewayV2VpcLinkExists(resourceName, &vpcLink),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSApiGatewayV2VpcLinkDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayv2
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_v2_vpc_link" {
+			continue
+		}
+
+		_, err := conn.GetVpcLink(&apigatewayv2.GetVpcLinkInput{
+			VpcLinkId: aws.String(rs.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("API Gateway v2 VPC Link %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSApiGatewayV2VpcLinkExists(n string, res *apigatewayv2.GetVpcLinkOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway v2 VPC Link ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayv2
+		resp, err := conn.GetVpcLink(&apigatewayv2.GetVpcLinkInput{
+			VpcLinkId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*res = *resp
+		return nil
+	}
+}
+
+func testAccAWSApiGatewayV2VpcLinkConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id     = "${aws_vpc.test.id}"
+  cidr_block = "10.0.0.0/24"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_api_gateway_v2_vpc_link" "test" {
+  name               = %[1]q
+  subnet_ids         = ["${aws_subnet.test.id}"]
+  security_group_ids = ["${aws_security_group.test.id}"]
+
+  tags = {
+    Environment = "test"
+  }
+}
+`, rName)
+}
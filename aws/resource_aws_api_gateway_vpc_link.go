@@ -2,9 +2,11 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -17,6 +19,15 @@ func resourceAwsApiGatewayVpcLink() *schema.Resource {
 		Read:   resourceAwsApiGatewayVpcLinkRead,
 		Update: resourceAwsApiGatewayVpcLinkUpdate,
 		Delete: resourceAwsApiGatewayVpcLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -33,6 +44,11 @@ func resourceAwsApiGatewayVpcLink() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"tags": tagsSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -48,7 +64,18 @@ func resourceAwsApiGatewayVpcLinkCreate(d *schema.ResourceData, meta interface{}
 		input.Description = aws.String(v.(string))
 	}
 
-	resp, err := conn.CreateVpcLink(input)
+	var resp *apigateway.CreateVpcLinkOutput
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		resp, err = conn.CreateVpcLink(input)
+		if err != nil {
+			if isAwsApiGatewayVpcLinkRetryableError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -57,7 +84,7 @@ func resourceAwsApiGatewayVpcLinkCreate(d *schema.ResourceData, meta interface{}
 		Pending:    []string{apigateway.VpcLinkStatusPending},
 		Target:     []string{apigateway.VpcLinkStatusAvailable},
 		Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, *resp.Id),
-		Timeout:    10 * time.Minute,
+		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -68,6 +95,17 @@ func resourceAwsApiGatewayVpcLinkCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	d.SetId(*resp.Id)
+
+	if v, ok := d.GetOk("tags"); ok {
+		if err := setTagsGeneric(conn, d.Id(), nil, v.(map[string]interface{})); err != nil {
+			return fmt.Errorf("Error tagging API Gateway Vpc Link %s: %s", d.Id(), err)
+		}
+	}
+
+	if err := resourceAwsApiGatewayVpcLinkRead(d, meta); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -93,6 +131,15 @@ func resourceAwsApiGatewayVpcLinkRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", resp.Name)
 	d.Set("description", resp.Description)
 	d.Set("target_arns", schema.NewSet(schema.HashString, flattenStringList(resp.TargetArns)))
+	d.Set("tags", tagsToMapGeneric(resp.Tags))
+
+	d.Set("arn", arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "apigateway",
+		Region:    meta.(*AWSClient).region,
+		Resource:  fmt.Sprintf("/vpclinks/%s", d.Id()),
+	}.String())
+
 	return nil
 }
 
@@ -141,35 +188,52 @@ func resourceAwsApiGatewayVpcLinkUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	input := &apigateway.UpdateVpcLinkInput{
-		VpcLinkId:       aws.String(d.Id()),
-		PatchOperations: operations,
-	}
+	if len(operations) > 0 {
+		input := &apigateway.UpdateVpcLinkInput{
+			VpcLinkId:       aws.String(d.Id()),
+			PatchOperations: operations,
+		}
 
-	_, err := conn.UpdateVpcLink(input)
-	if err != nil {
-		if ecrerr, ok := err.(awserr.Error); ok {
-			switch ecrerr.Code() {
-			case apigateway.ErrCodeNotFoundException:
-				d.SetId("")
-				return nil
+		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+			_, err := conn.UpdateVpcLink(input)
+			if err != nil {
+				if isAwsApiGatewayVpcLinkRetryableError(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			if ecrerr, ok := err.(awserr.Error); ok {
+				switch ecrerr.Code() {
+				case apigateway.ErrCodeNotFoundException:
+					d.SetId("")
+					return nil
+				}
 			}
+			return err
 		}
-		return err
-	}
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{apigateway.VpcLinkStatusPending},
-		Target:     []string{apigateway.VpcLinkStatusAvailable},
-		Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, d.Id()),
-		Timeout:    10 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{apigateway.VpcLinkStatusPending},
+			Target:     []string{apigateway.VpcLinkStatusAvailable},
+			Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("[WARN] Error waiting for APIGateway Vpc Link status to be \"%s\": %s", apigateway.VpcLinkStatusAvailable, err)
+		}
 	}
 
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("[WARN] Error waiting for APIGateway Vpc Link status to be \"%s\": %s", apigateway.VpcLinkStatusAvailable, err)
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		if err := setTagsGeneric(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("Error updating API Gateway Vpc Link tags: %s", err)
+		}
 	}
 
 	return nil
@@ -194,6 +258,20 @@ func resourceAwsApiGatewayVpcLinkDelete(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{apigateway.VpcLinkStatusPending, apigateway.VpcLinkStatusAvailable, apigateway.VpcLinkStatusDeleting},
+		Target:     []string{},
+		Refresh:    apigatewayVpcLinkRefreshStatusFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("[WARN] Error waiting for APIGateway Vpc Link to be deleted: %s", err)
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -205,8 +283,31 @@ func apigatewayVpcLinkRefreshStatusFunc(conn *apigateway.APIGateway, vl string)
 		}
 		resp, err := conn.GetVpcLink(input)
 		if err != nil {
+			if ecrerr, ok := err.(awserr.Error); ok {
+				switch ecrerr.Code() {
+				case apigateway.ErrCodeNotFoundException:
+					return nil, "", nil
+				}
+			}
 			return nil, "failed", err
 		}
 		return resp, *resp.Status, nil
 	}
-}
\ No newline at end of file
+}
+
+func isAwsApiGatewayVpcLinkRetryableError(err error) bool {
+	ecrerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+
+	if ecrerr.Code() == apigateway.ErrCodeTooManyRequestsException {
+		return true
+	}
+
+	if ecrerr.Code() == apigateway.ErrCodeBadRequestException && strings.Contains(ecrerr.Message(), "VPC endpoint service") {
+		return true
+	}
+
+	return false
+}